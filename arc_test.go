@@ -0,0 +1,64 @@
+package cache
+
+import "testing"
+
+// TestARCGhostHitPromotesToT2 checks the core adaptive behavior: a key
+// evicted into B1 that gets re-added is treated as frequent (promoted
+// straight into T2) and B1's hit grows p, the target size of T1.
+func TestARCGhostHitPromotesToT2(t *testing.T) {
+	a := NewARC[int, string](3)
+
+	a.Set(1, "a")
+	a.Set(2, "b")
+	a.Set(3, "c")
+	a.Get(1) // promotes key 1 from T1 to T2, so T1 has room to shrink again
+
+	a.Set(4, "d") // new key: T1 is below capacity now, so this goes through
+	// replace() instead of the direct no-ghost eviction, pushing key 2's
+	// LRU T1 entry into the B1 ghost list.
+	if _, ok := a.mb1[2]; !ok {
+		t.Fatal("key 2 should have been evicted from T1 into the B1 ghost list")
+	}
+
+	pBefore := a.p
+	a.Set(2, "b-again") // ghost hit in B1
+
+	if a.p <= pBefore {
+		t.Fatalf("p did not grow on a B1 ghost hit: before=%d after=%d", pBefore, a.p)
+	}
+	if _, ok := a.m2[2]; !ok {
+		t.Fatal("key 2 should have been promoted into T2 on its B1 ghost hit")
+	}
+	if _, ok := a.mb1[2]; ok {
+		t.Fatal("key 2 should have been removed from B1 once promoted")
+	}
+	if v, ok := a.Get(2); !ok || v != "b-again" {
+		t.Fatalf("Get(2) = %q, %v; want %q, true", v, ok, "b-again")
+	}
+}
+
+// TestARCRemoveAndLen exercises the bookkeeping methods that parallel
+// Cache's surface.
+func TestARCRemoveAndLen(t *testing.T) {
+	a := NewARC[int, string](3)
+	a.Set(1, "a")
+	a.Set(2, "b")
+
+	if got := a.Len(); got != 2 {
+		t.Fatalf("Len() = %d, want 2", got)
+	}
+	if !a.Remove(1) {
+		t.Fatal("Remove(1) = false, want true")
+	}
+	if _, ok := a.Get(1); ok {
+		t.Fatal("key 1 should be gone after Remove")
+	}
+	if got := a.Len(); got != 1 {
+		t.Fatalf("Len() after Remove = %d, want 1", got)
+	}
+
+	a.Purge()
+	if got := a.Len(); got != 0 {
+		t.Fatalf("Len() after Purge = %d, want 0", got)
+	}
+}
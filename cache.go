@@ -1,53 +1,111 @@
 package cache
 
 import (
-	"container/list"
 	"sync"
-)
-
-type Cache struct {
-	mu             sync.Mutex                    // A mutex to ensure thread-safe operations
-	capacity       int                           // Cache capacity
-	storage        map[interface{}]*list.Element // hashmap: key-item key, value doubly linked list node reference
-	evictionList   *list.List                    // doubly linked list to keep track of the order of items for eviction purposes
-	evictionPolicy EvictionPolicy                // An interface to define the eviction policy (e.g., FIFO, LRU, LIFO)
-}
-
-// CacheItem is represents a cached item
-// for internal use only
-type CacheItem struct {
-	Key   interface{} // The key of the cache item
-	Value interface{} // The value of the cache item
-}
+	"time"
 
-type EvictionPolicy interface {
-	Add(evictionList *list.List, item *list.Element)    // Method to add an item to the eviction list
-	Remove(evictionList *list.List) *list.Element       // Method to remove an item from the eviction list
-	Access(evictionList *list.List, item *list.Element) // Method to mark an item as accessed
-}
+	"github.com/atul-007/cached/internal"
+)
 
-// A constructor function to create a new Cache instance
+// EvictionPolicy decides which entry to evict once a Cache is at capacity.
+// Implementations are free to keep their own state (e.g. SIEVE's hand), so
+// a policy value must not be shared between two Cache instances.
+type EvictionPolicy[K comparable, V any] interface {
+	Add(evictionList *internal.List[K, V], item *internal.Entry[K, V])    // Method to add an item to the eviction list
+	Remove(evictionList *internal.List[K, V]) *internal.Entry[K, V]       // Method to remove an item from the eviction list
+	Access(evictionList *internal.List[K, V], item *internal.Entry[K, V]) // Method to mark an item as accessed
+
+	// Evicted notifies the policy that item has been unlinked from
+	// evictionList (or, for Purge, is being discarded along with it). Cache
+	// calls it after a capacity eviction chosen by this policy's own Remove
+	// and also on the paths that bypass Remove — an overwriting Set, TTL
+	// expiry, an explicit Cache.Remove, or Purge. Stateless policies
+	// (FIFO, LRU, LIFO) can ignore it; stateful ones (SIEVE's hand) must
+	// drop any reference to item here or they'll dereference a detached
+	// node on the next Remove call.
+	Evicted(evictionList *internal.List[K, V], item *internal.Entry[K, V])
+}
+
+// Cache is a fixed-capacity, thread-safe cache parameterized on its key and
+// value types. Keeping K/V typed end to end (rather than interface{}) means
+// Get no longer needs a type assertion and Set no longer boxes values.
+type Cache[K comparable, V any] struct {
+	mu              sync.Mutex                  // A mutex to ensure thread-safe operations
+	capacity        int                         // Cache capacity
+	storage         map[K]*internal.Entry[K, V] // hashmap: key -> doubly linked list node reference
+	evictionList    *internal.List[K, V]        // doubly linked list to keep track of the order of items for eviction purposes
+	evictionPolicy  EvictionPolicy[K, V]        // An interface to define the eviction policy (e.g., FIFO, LRU, LIFO)
+	defaultTTL      time.Duration               // applied by Set when non-zero; SetWithTTL overrides it per call
+	cleanupInterval time.Duration               // if non-zero, a janitor goroutine sweeps expired entries at this period
+	onEvicted       func(key K, value V, reason EvictReason)
+	stopCh          chan struct{} // closed by Close to stop the janitor goroutine
+}
+
+// NewCache is a constructor function to create a new Cache instance.
 // Initializes the capacity, storage, evictionList, and evictionPolicy fields
 // Returns a pointer to the new Cache instance
-func NewCache(capacity int, policy EvictionPolicy) *Cache {
-	return &Cache{
+func NewCache[K comparable, V any](capacity int, policy EvictionPolicy[K, V], opts ...Option[K, V]) *Cache[K, V] {
+	return newCache(capacity, policy, opts...)
+}
+
+func newCache[K comparable, V any](capacity int, policy EvictionPolicy[K, V], opts ...Option[K, V]) *Cache[K, V] {
+	c := &Cache[K, V]{
 		capacity:       capacity,
-		storage:        make(map[interface{}]*list.Element),
-		evictionList:   list.New(),
+		storage:        make(map[K]*internal.Entry[K, V]),
+		evictionList:   internal.NewList[K, V](),
 		evictionPolicy: policy,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.cleanupInterval > 0 {
+		c.stopCh = make(chan struct{})
+		go c.janitor(c.cleanupInterval, c.stopCh)
+	}
+	return c
+}
+
+// NewInterfaceCache builds a Cache[interface{}, interface{}], the closest
+// equivalent to the old interface{}-based Cache, for callers not yet ready
+// to parameterize their call sites.
+//
+// This is NOT a backward-compatible shim: it does not make old call sites
+// compile unmodified. `cache.NewCache(n, cache.NewFIFO())` still fails,
+// both because the bare type name Cache now always requires [K, V] and
+// because FIFO/LRU/LIFO/SIEVE's zero-arg constructors give Go nothing to
+// infer K/V from — callers must write NewInterfaceCache(n,
+// NewFIFO[interface{}, interface{}]()) or, better, adopt concrete types.
+// Upgrading past this commit is a breaking change, not a drop-in one.
+//
+// Deprecated: use NewCache[K, V] with concrete types instead.
+func NewInterfaceCache(capacity int, policy EvictionPolicy[interface{}, interface{}]) *Cache[interface{}, interface{}] {
+	return NewCache[interface{}, interface{}](capacity, policy)
+}
+
+func (c *Cache[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.setLocked(key, value, c.defaultTTL)
 }
 
-func (c *Cache) Set(key, value interface{}) {
-	//  mutex lock
-	// Prevents multiple threads from writing into to the same key in parallel
+// SetWithTTL is like Set but expires the entry after ttl regardless of the
+// cache's defaultTTL. A ttl of zero means the entry never expires on its
+// own (it can still be evicted by the eviction policy).
+func (c *Cache[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
+	c.setLocked(key, value, ttl)
+}
+
+func (c *Cache[K, V]) setLocked(key K, value V, ttl time.Duration) {
+	// Callers must hold c.mu, which prevents multiple goroutines from writing
+	// into the same key in parallel
 
 	// Check if the item is already in the cache
 	// If item is already in cache it removes  it from the cache
 	if el, ok := c.storage[key]; ok {
 		c.evictionList.Remove(el)
+		c.evictionPolicy.Evicted(c.evictionList, el)
 		delete(c.storage, key)
 	}
 
@@ -56,91 +114,128 @@ func (c *Cache) Set(key, value interface{}) {
 	if len(c.storage) >= c.capacity {
 		el := c.evictionPolicy.Remove(c.evictionList)
 		if el != nil {
-			item := el.Value.(*CacheItem)
-			delete(c.storage, item.Key)
+			delete(c.storage, el.Key)
 			c.evictionList.Remove(el)
+			c.evictionPolicy.Evicted(c.evictionList, el)
+			c.notifyEvicted(el, EvictReasonCapacity)
 		}
 	}
 
 	// If the item is not in cache add it to the cache (hashmap and doubly linked list) after the capacity has been checked
-	item := &CacheItem{Key: key, Value: value}
-	el := c.evictionList.PushFront(item)
+	el := c.evictionList.PushFront(key, value)
+	if ttl > 0 {
+		el.ExpiresAt = time.Now().Add(ttl)
+	}
 	c.storage[key] = el
 	c.evictionPolicy.Add(c.evictionList, el)
 }
 
 // Get returns the cached element corresponding to the given key
 // It also calls the access function of the given eviction policy(only required for LRU to move the item to the front of the list)
-func (c *Cache) Get(key interface{}) (interface{}, bool) {
+func (c *Cache[K, V]) Get(key K) (V, bool) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if el, ok := c.storage[key]; ok {
-		c.evictionPolicy.Access(c.evictionList, el)
-		return el.Value.(*CacheItem).Value, true
+	el, ok := c.storage[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	if c.expired(el) {
+		c.removeEntry(el, EvictReasonExpired)
+		var zero V
+		return zero, false
 	}
-	return nil, false
-}
-
-// FIFO (First In First Out)
-type FIFO struct{}
-
-func NewFIFO() *FIFO {
-	return &FIFO{}
-}
 
-func (p *FIFO) Add(evictionList *list.List, item *list.Element) {
-	// No operation needed for FIFO add
+	c.evictionPolicy.Access(c.evictionList, el)
+	return el.Value, true
 }
 
-func (p *FIFO) Remove(evictionList *list.List) *list.Element {
-	// FIFO removes from the back (oldest item)
-	return evictionList.Back()
-}
+// Peek returns the cached element corresponding to the given key without
+// updating the eviction policy's access order.
+func (c *Cache[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
-func (p *FIFO) Access(evictionList *list.List, item *list.Element) {
-	// No operation needed for FIFO access
+	el, ok := c.storage[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	if c.expired(el) {
+		c.removeEntry(el, EvictReasonExpired)
+		var zero V
+		return zero, false
+	}
+	return el.Value, true
 }
 
-// LRU (Least Recently Used)
-type LRU struct{}
+// Remove deletes the entry for key, if present, and reports whether
+// anything was removed.
+func (c *Cache[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
-func NewLRU() *LRU {
-	return &LRU{}
+	el, ok := c.storage[key]
+	if !ok {
+		return false
+	}
+	c.removeEntry(el, EvictReasonRemoved)
+	return true
 }
 
-func (p *LRU) Add(evictionList *list.List, item *list.Element) {
-	// No operation needed for LRU add
-	// Note: Least recently used item will be at the back of the doubly linked list(last node in doubly linked list)
-
+// Len returns the number of entries currently in the cache, including any
+// not-yet-swept expired entries.
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.storage)
 }
 
-func (p *LRU) Remove(evictionList *list.List) *list.Element {
-	// LRU removes from the back (least recently used item)
-	return evictionList.Back()
-}
+// Purge removes all entries from the cache, invoking OnEvicted for each
+// with EvictReasonRemoved.
+func (c *Cache[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
-func (p *LRU) Access(evictionList *list.List, item *list.Element) {
-	// Moves the item to the front of the list
-	evictionList.MoveToFront(item)
+	for el := c.evictionList.Front(); el != nil; el = el.Next() {
+		c.evictionPolicy.Evicted(c.evictionList, el)
+		c.notifyEvicted(el, EvictReasonRemoved)
+	}
+	c.storage = make(map[K]*internal.Entry[K, V])
+	c.evictionList = internal.NewList[K, V]()
 }
 
-// LIFO (Last In First Out)
-type LIFO struct{}
+// Close stops the background janitor goroutine started by WithCleanupInterval,
+// if any. It is safe to call on a Cache with no janitor running.
+func (c *Cache[K, V]) Close() {
+	c.mu.Lock()
+	stopCh := c.stopCh
+	c.stopCh = nil
+	c.mu.Unlock()
 
-func NewLIFO() *LIFO {
-	return &LIFO{}
+	if stopCh != nil {
+		close(stopCh)
+	}
 }
 
-func (p *LIFO) Add(evictionList *list.List, item *list.Element) {
-	// No operation needed for LIFO add
+func (c *Cache[K, V]) expired(el *internal.Entry[K, V]) bool {
+	return !el.ExpiresAt.IsZero() && !el.ExpiresAt.After(time.Now())
 }
 
-func (p *LIFO) Remove(evictionList *list.List) *list.Element {
-	// LIFO removes from the front (most recently added item)
-	return evictionList.Front()
+// removeEntry unlinks el from the storage map and eviction list, notifies
+// the eviction policy so it can drop any internal reference to el, and
+// fires OnEvicted. Callers must hold c.mu.
+func (c *Cache[K, V]) removeEntry(el *internal.Entry[K, V], reason EvictReason) {
+	delete(c.storage, el.Key)
+	c.evictionList.Remove(el)
+	c.evictionPolicy.Evicted(c.evictionList, el)
+	c.notifyEvicted(el, reason)
 }
 
-func (p *LIFO) Access(evictionList *list.List, item *list.Element) {
-	// No operation needed for LIFO access
+// notifyEvicted fires OnEvicted, if set. Callers must hold c.mu.
+func (c *Cache[K, V]) notifyEvicted(el *internal.Entry[K, V], reason EvictReason) {
+	if c.onEvicted != nil {
+		c.onEvicted(el.Key, el.Value, reason)
+	}
 }
@@ -0,0 +1,79 @@
+package cache
+
+import "testing"
+
+// Regression test: SIEVE's hand must not outlive the node it points at when
+// that node is unlinked by something other than the policy's own Remove
+// (an explicit Cache.Remove, TTL expiry, or Purge) — otherwise the next
+// eviction dereferences a detached node.
+func TestCacheRemoveThenEvictDoesNotPanic(t *testing.T) {
+	c := NewCache[int, int](3, NewSIEVE[int, int]())
+
+	c.Set(1, 1)
+	c.Set(2, 2)
+	c.Set(3, 3)
+	c.Set(4, 4) // over capacity: seeds the hand by evicting once
+
+	for _, key := range []int{1, 2, 3, 4} {
+		c.Remove(key)
+	}
+
+	// Previously panicked with a nil-pointer dereference in internal.List.Remove
+	// because the hand was left pointing at an already-detached node.
+	c.Set(5, 5)
+	c.Set(6, 6)
+	c.Set(7, 7)
+
+	if got := c.Len(); got != 3 {
+		t.Fatalf("Len() = %d, want 3", got)
+	}
+}
+
+// Regression test: Purge must also invalidate the hand, or SIEVE keeps
+// returning a victim that belongs to the list Purge just discarded, and the
+// post-Purge cache never actually shrinks back down to capacity.
+func TestCachePurgeThenSetRespectsCapacity(t *testing.T) {
+	c := NewCache[int, int](3, NewSIEVE[int, int]())
+
+	c.Set(1, 1)
+	c.Set(2, 2)
+	c.Set(3, 3)
+	c.Set(4, 4) // seeds the hand
+
+	c.Purge()
+	if got := c.Len(); got != 0 {
+		t.Fatalf("Len() after Purge = %d, want 0", got)
+	}
+
+	for _, key := range []int{10, 20, 30, 40, 50} {
+		c.Set(key, key)
+	}
+
+	if got := c.Len(); got != 3 {
+		t.Fatalf("Len() = %d, want 3", got)
+	}
+}
+
+// Regression test: overwriting an existing key (the update-in-place path in
+// setLocked) unlinks the old node directly, without going through the
+// eviction policy's own Remove. If the hand happened to be parked on that
+// node, it must still be invalidated here, or the next capacity eviction
+// dereferences an already-detached node.
+func TestCacheSetOverwriteThenEvictDoesNotPanic(t *testing.T) {
+	c := NewCache[int, string](3, NewSIEVE[int, string]())
+
+	c.Set(1, "a")
+	c.Set(2, "b")
+	c.Set(3, "c")
+	c.Set(4, "d") // over capacity: seeds the hand
+
+	c.Set(2, "updated") // overwrites key 2 in place; may detach the hand's node
+
+	// Previously panicked with a nil-pointer dereference in internal.List.Remove
+	// because the hand was left pointing at the node Set just detached.
+	c.Set(5, "e")
+
+	if got := c.Len(); got != 3 {
+		t.Fatalf("Len() = %d, want 3", got)
+	}
+}
@@ -0,0 +1,27 @@
+package cache
+
+import "github.com/atul-007/cached/internal"
+
+// FIFO (First In First Out)
+type FIFO[K comparable, V any] struct{}
+
+func NewFIFO[K comparable, V any]() *FIFO[K, V] {
+	return &FIFO[K, V]{}
+}
+
+func (p *FIFO[K, V]) Add(evictionList *internal.List[K, V], item *internal.Entry[K, V]) {
+	// No operation needed for FIFO add
+}
+
+func (p *FIFO[K, V]) Remove(evictionList *internal.List[K, V]) *internal.Entry[K, V] {
+	// FIFO removes from the back (oldest item)
+	return evictionList.Back()
+}
+
+func (p *FIFO[K, V]) Access(evictionList *internal.List[K, V], item *internal.Entry[K, V]) {
+	// No operation needed for FIFO access
+}
+
+func (p *FIFO[K, V]) Evicted(evictionList *internal.List[K, V], item *internal.Entry[K, V]) {
+	// FIFO keeps no state about individual entries, so there is nothing to invalidate
+}
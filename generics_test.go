@@ -0,0 +1,65 @@
+package cache
+
+import "testing"
+
+// TestCacheGenericGetReturnsTypedValueDirectly exercises the generic API's
+// main selling point: Get returns a V directly, no interface{} round trip
+// or type assertion required at the call site.
+func TestCacheGenericGetReturnsTypedValueDirectly(t *testing.T) {
+	c := NewCache[string, int](2, NewLRU[string, int]())
+	c.Set("a", 1)
+
+	v, ok := c.Get("a")
+	if !ok {
+		t.Fatal("expected a hit for key \"a\"")
+	}
+	var _ int = v // compiles without a type assertion
+	if v != 1 {
+		t.Fatalf("Get(%q) = %d, want 1", "a", v)
+	}
+
+	if _, ok := c.Get("missing"); ok {
+		t.Fatal("expected a miss for an absent key")
+	}
+}
+
+// TestNewInterfaceCache exercises the interface{}-keyed/valued constructor.
+// It is not a drop-in replacement for the old non-generic Cache (see the
+// doc comment on NewInterfaceCache) — this only confirms the type it does
+// produce, Cache[interface{}, interface{}], behaves like the old Cache once
+// a caller has updated their construction call.
+func TestNewInterfaceCache(t *testing.T) {
+	c := NewInterfaceCache(2, NewFIFO[interface{}, interface{}]())
+
+	c.Set("a", 1)
+	c.Set("b", "two")
+
+	v, ok := c.Get("a")
+	if !ok || v != 1 {
+		t.Fatalf("Get(%q) = %v, %v; want 1, true", "a", v, ok)
+	}
+	v, ok = c.Get("b")
+	if !ok || v != "two" {
+		t.Fatalf("Get(%q) = %v, %v; want %q, true", "b", v, ok, "two")
+	}
+
+	c.Set("c", 3.0) // over capacity: evicts "a" under FIFO
+	if _, ok := c.Get("a"); ok {
+		t.Fatal("expected key \"a\" to have been evicted once over capacity")
+	}
+}
+
+// BenchmarkCacheSetGet reports allocations for the hot Set/Get path. With
+// K/V stored directly on internal.Entry instead of boxed behind
+// interface{}, Get allocates nothing and Set allocates only the list entry
+// it inserts, beyond the occasional map growth.
+func BenchmarkCacheSetGet(b *testing.B) {
+	c := NewCache[int, int](1024, NewLRU[int, int]())
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		key := i % 1024
+		c.Set(key, i)
+		c.Get(key)
+	}
+}
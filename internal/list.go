@@ -0,0 +1,120 @@
+// Package internal provides the typed intrusive doubly linked list used by
+// cache.Cache[K, V]. It plays the same role container/list played for the
+// old interface{}-based Cache, but stores K/V directly on each Entry so the
+// hot path (Get/Set) never boxes a value or type-asserts it back out.
+package internal
+
+import "time"
+
+// Entry is a node in a List[K, V]. The zero value is not usable; entries
+// are only created through List.PushFront.
+type Entry[K comparable, V any] struct {
+	Key     K
+	Value   V
+	Visited bool // used by the SIEVE policy; ignored by the others
+
+	ExpiresAt time.Time // zero if the entry has no TTL; set and read by Cache, not by policies
+
+	list       *List[K, V]
+	prev, next *Entry[K, V]
+}
+
+// Prev returns the entry preceding e in the list, or nil if e is the front
+// element.
+func (e *Entry[K, V]) Prev() *Entry[K, V] {
+	if p := e.prev; e.list != nil && p != &e.list.root {
+		return p
+	}
+	return nil
+}
+
+// Next returns the entry following e in the list, or nil if e is the back
+// element.
+func (e *Entry[K, V]) Next() *Entry[K, V] {
+	if n := e.next; e.list != nil && n != &e.list.root {
+		return n
+	}
+	return nil
+}
+
+// List is a typed doubly linked list, structured like container/list's ring
+// (a sentinel root entry so Front/Back/insert need no nil-special-casing).
+type List[K comparable, V any] struct {
+	root Entry[K, V]
+	len  int
+}
+
+// NewList returns an initialized, empty List.
+func NewList[K comparable, V any]() *List[K, V] {
+	l := &List[K, V]{}
+	l.root.next = &l.root
+	l.root.prev = &l.root
+	l.root.list = l
+	return l
+}
+
+// Len returns the number of entries in the list.
+func (l *List[K, V]) Len() int { return l.len }
+
+// Front returns the first entry of the list, or nil if the list is empty.
+func (l *List[K, V]) Front() *Entry[K, V] {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.next
+}
+
+// Back returns the last entry of the list, or nil if the list is empty.
+func (l *List[K, V]) Back() *Entry[K, V] {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+
+func (l *List[K, V]) insert(e, at *Entry[K, V]) *Entry[K, V] {
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+	e.list = l
+	l.len++
+	return e
+}
+
+// PushFront inserts a new entry with the given key and value at the front
+// of the list and returns it.
+func (l *List[K, V]) PushFront(key K, value V) *Entry[K, V] {
+	return l.insert(&Entry[K, V]{Key: key, Value: value}, &l.root)
+}
+
+// Remove unlinks e from the list.
+func (l *List[K, V]) Remove(e *Entry[K, V]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.next = nil
+	e.prev = nil
+	e.list = nil
+	l.len--
+}
+
+func (l *List[K, V]) move(e, at *Entry[K, V]) {
+	if e == at {
+		return
+	}
+	e.prev.next = e.next
+	e.next.prev = e.prev
+
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+}
+
+// MoveToFront moves e to the front of the list.
+func (l *List[K, V]) MoveToFront(e *Entry[K, V]) {
+	if l.root.next == e {
+		return
+	}
+	l.move(e, &l.root)
+}
@@ -0,0 +1,27 @@
+package cache
+
+import "github.com/atul-007/cached/internal"
+
+// LIFO (Last In First Out)
+type LIFO[K comparable, V any] struct{}
+
+func NewLIFO[K comparable, V any]() *LIFO[K, V] {
+	return &LIFO[K, V]{}
+}
+
+func (p *LIFO[K, V]) Add(evictionList *internal.List[K, V], item *internal.Entry[K, V]) {
+	// No operation needed for LIFO add
+}
+
+func (p *LIFO[K, V]) Remove(evictionList *internal.List[K, V]) *internal.Entry[K, V] {
+	// LIFO removes from the front (most recently added item)
+	return evictionList.Front()
+}
+
+func (p *LIFO[K, V]) Access(evictionList *internal.List[K, V], item *internal.Entry[K, V]) {
+	// No operation needed for LIFO access
+}
+
+func (p *LIFO[K, V]) Evicted(evictionList *internal.List[K, V], item *internal.Entry[K, V]) {
+	// LIFO keeps no state about individual entries, so there is nothing to invalidate
+}
@@ -0,0 +1,29 @@
+package cache
+
+import "github.com/atul-007/cached/internal"
+
+// LRU (Least Recently Used)
+type LRU[K comparable, V any] struct{}
+
+func NewLRU[K comparable, V any]() *LRU[K, V] {
+	return &LRU[K, V]{}
+}
+
+func (p *LRU[K, V]) Add(evictionList *internal.List[K, V], item *internal.Entry[K, V]) {
+	// No operation needed for LRU add
+	// Note: Least recently used item will be at the back of the doubly linked list(last node in doubly linked list)
+}
+
+func (p *LRU[K, V]) Remove(evictionList *internal.List[K, V]) *internal.Entry[K, V] {
+	// LRU removes from the back (least recently used item)
+	return evictionList.Back()
+}
+
+func (p *LRU[K, V]) Access(evictionList *internal.List[K, V], item *internal.Entry[K, V]) {
+	// Moves the item to the front of the list
+	evictionList.MoveToFront(item)
+}
+
+func (p *LRU[K, V]) Evicted(evictionList *internal.List[K, V], item *internal.Entry[K, V]) {
+	// LRU keeps no state about individual entries, so there is nothing to invalidate
+}
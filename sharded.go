@@ -0,0 +1,173 @@
+package cache
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// ShardedCache wraps N independent Cache instances and routes each
+// operation to shards[hash(key)%N], so unrelated keys don't contend on the
+// same mutex the way they would behind a single Cache. Each shard owns its
+// own EvictionPolicy instance (built by policyFactory), since policy state
+// like SIEVE's hand can't be shared across shards.
+//
+// policyFactory's signature is func() EvictionPolicy[K, V], so only
+// FIFO/LRU/LIFO/SIEVE — the policies that plug into Cache[K, V] — can be
+// sharded this way. ARC and TwoQueue are standalone cache types, not
+// EvictionPolicy implementations (see the doc comment on ARC), so they
+// can't be passed to NewShardedCache at all; use NewShardedARC /
+// NewShardedTwoQueue instead, which shard those types directly. Those also
+// don't carry Cache's TTL/OnEvicted support, since ARC/TwoQueue don't have
+// it to begin with.
+type ShardedCache[K comparable, V any] struct {
+	shards []*Cache[K, V]
+}
+
+// NewShardedCache returns a ShardedCache with the given number of shards,
+// each an independent Cache[K, V] of perShardCapacity, built via
+// policyFactory so every shard gets its own policy state.
+func NewShardedCache[K comparable, V any](shards, perShardCapacity int, policyFactory func() EvictionPolicy[K, V]) *ShardedCache[K, V] {
+	cs := make([]*Cache[K, V], shards)
+	for i := range cs {
+		cs[i] = NewCache[K, V](perShardCapacity, policyFactory())
+	}
+	return &ShardedCache[K, V]{shards: cs}
+}
+
+// shardKey hashes an arbitrary comparable key via fnv-1a over its %v
+// representation. This works for any K at the cost of an allocation per
+// call; callers hashing a huge number of keys per second over a
+// string/int-keyed cache may prefer a typed hash instead.
+func shardKey[K comparable](key K) uint32 {
+	h := fnv.New32a()
+	fmt.Fprintf(h, "%v", key)
+	return h.Sum32()
+}
+
+func (s *ShardedCache[K, V]) shardFor(key K) *Cache[K, V] {
+	return s.shards[shardKey(key)%uint32(len(s.shards))]
+}
+
+// Get looks up key in its shard.
+func (s *ShardedCache[K, V]) Get(key K) (V, bool) {
+	return s.shardFor(key).Get(key)
+}
+
+// Set stores key/value in its shard.
+func (s *ShardedCache[K, V]) Set(key K, value V) {
+	s.shardFor(key).Set(key, value)
+}
+
+// Remove deletes key from its shard and reports whether anything was
+// removed.
+func (s *ShardedCache[K, V]) Remove(key K) bool {
+	return s.shardFor(key).Remove(key)
+}
+
+// Len returns the total number of entries across all shards. It is not a
+// consistent snapshot under concurrent writes, since each shard is summed
+// under its own lock rather than one lock covering all shards.
+func (s *ShardedCache[K, V]) Len() int {
+	total := 0
+	for _, shard := range s.shards {
+		total += shard.Len()
+	}
+	return total
+}
+
+// ShardedARC wraps N independent ARC caches and routes each operation to
+// shards[hash(key)%N], the ARC equivalent of ShardedCache. ARC isn't an
+// EvictionPolicy (see the doc comment on ARC), so it can't go through
+// NewShardedCache; this exists so sharding it doesn't require hand-rolling
+// the same routing logic again.
+type ShardedARC[K comparable, V any] struct {
+	shards []*ARC[K, V]
+}
+
+// NewShardedARC returns a ShardedARC with the given number of shards, each
+// an independent ARC[K, V] of perShardCapacity.
+func NewShardedARC[K comparable, V any](shards, perShardCapacity int) *ShardedARC[K, V] {
+	cs := make([]*ARC[K, V], shards)
+	for i := range cs {
+		cs[i] = NewARC[K, V](perShardCapacity)
+	}
+	return &ShardedARC[K, V]{shards: cs}
+}
+
+func (s *ShardedARC[K, V]) shardFor(key K) *ARC[K, V] {
+	return s.shards[shardKey(key)%uint32(len(s.shards))]
+}
+
+// Get looks up key in its shard.
+func (s *ShardedARC[K, V]) Get(key K) (V, bool) {
+	return s.shardFor(key).Get(key)
+}
+
+// Set stores key/value in its shard.
+func (s *ShardedARC[K, V]) Set(key K, value V) {
+	s.shardFor(key).Set(key, value)
+}
+
+// Remove deletes key from its shard and reports whether anything was
+// removed.
+func (s *ShardedARC[K, V]) Remove(key K) bool {
+	return s.shardFor(key).Remove(key)
+}
+
+// Len returns the total number of live entries across all shards; see
+// ShardedCache.Len for the same consistency caveat.
+func (s *ShardedARC[K, V]) Len() int {
+	total := 0
+	for _, shard := range s.shards {
+		total += shard.Len()
+	}
+	return total
+}
+
+// ShardedTwoQueue wraps N independent TwoQueue caches and routes each
+// operation to shards[hash(key)%N], the 2Q equivalent of ShardedCache. Like
+// ARC, TwoQueue isn't an EvictionPolicy, so it can't go through
+// NewShardedCache either.
+type ShardedTwoQueue[K comparable, V any] struct {
+	shards []*TwoQueue[K, V]
+}
+
+// NewShardedTwoQueue returns a ShardedTwoQueue with the given number of
+// shards, each an independent TwoQueue[K, V] of perShardCapacity.
+func NewShardedTwoQueue[K comparable, V any](shards, perShardCapacity int) *ShardedTwoQueue[K, V] {
+	cs := make([]*TwoQueue[K, V], shards)
+	for i := range cs {
+		cs[i] = NewTwoQueue[K, V](perShardCapacity)
+	}
+	return &ShardedTwoQueue[K, V]{shards: cs}
+}
+
+func (s *ShardedTwoQueue[K, V]) shardFor(key K) *TwoQueue[K, V] {
+	return s.shards[shardKey(key)%uint32(len(s.shards))]
+}
+
+// Get looks up key in its shard.
+func (s *ShardedTwoQueue[K, V]) Get(key K) (V, bool) {
+	return s.shardFor(key).Get(key)
+}
+
+// Set stores key/value in its shard.
+func (s *ShardedTwoQueue[K, V]) Set(key K, value V) {
+	s.shardFor(key).Set(key, value)
+}
+
+// Remove deletes key from its shard and reports whether anything was
+// removed.
+func (s *ShardedTwoQueue[K, V]) Remove(key K) bool {
+	return s.shardFor(key).Remove(key)
+}
+
+// Len returns the total number of live entries across all shards; see
+// ShardedCache.Len for the same consistency caveat.
+func (s *ShardedTwoQueue[K, V]) Len() int {
+	total := 0
+	for _, shard := range s.shards {
+		total += shard.Len()
+	}
+	return total
+}
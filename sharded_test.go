@@ -0,0 +1,122 @@
+package cache
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestShardedCacheRoutesAndCounts checks that gets/sets survive being
+// spread across shards and that Len sums every shard.
+func TestShardedCacheRoutesAndCounts(t *testing.T) {
+	sc := NewShardedCache[int, string](4, 16, func() EvictionPolicy[int, string] {
+		return NewLRU[int, string]()
+	})
+
+	const n = 50
+	for i := 0; i < n; i++ {
+		sc.Set(i, fmt.Sprintf("v%d", i))
+	}
+	if got := sc.Len(); got != n {
+		t.Fatalf("Len() = %d, want %d", got, n)
+	}
+	for i := 0; i < n; i++ {
+		if v, ok := sc.Get(i); !ok || v != fmt.Sprintf("v%d", i) {
+			t.Fatalf("Get(%d) = %q, %v; want v%d, true", i, v, ok, i)
+		}
+	}
+
+	if !sc.Remove(0) {
+		t.Fatal("Remove(0) = false, want true")
+	}
+	if _, ok := sc.Get(0); ok {
+		t.Fatal("key 0 should be gone after Remove")
+	}
+}
+
+// TestShardedARCRoutesAndCounts mirrors TestShardedCacheRoutesAndCounts for
+// ShardedARC, the sharding equivalent for ARC (which can't use
+// NewShardedCache; see the doc comment on ARC).
+func TestShardedARCRoutesAndCounts(t *testing.T) {
+	sa := NewShardedARC[int, string](4, 16)
+
+	const n = 50
+	for i := 0; i < n; i++ {
+		sa.Set(i, fmt.Sprintf("v%d", i))
+	}
+	if got := sa.Len(); got != n {
+		t.Fatalf("Len() = %d, want %d", got, n)
+	}
+	for i := 0; i < n; i++ {
+		if v, ok := sa.Get(i); !ok || v != fmt.Sprintf("v%d", i) {
+			t.Fatalf("Get(%d) = %q, %v; want v%d, true", i, v, ok, i)
+		}
+	}
+
+	if !sa.Remove(0) {
+		t.Fatal("Remove(0) = false, want true")
+	}
+	if _, ok := sa.Get(0); ok {
+		t.Fatal("key 0 should be gone after Remove")
+	}
+}
+
+// TestShardedTwoQueueRoutesAndCounts mirrors TestShardedCacheRoutesAndCounts
+// for ShardedTwoQueue.
+func TestShardedTwoQueueRoutesAndCounts(t *testing.T) {
+	sq := NewShardedTwoQueue[int, string](4, 16)
+
+	const n = 50
+	for i := 0; i < n; i++ {
+		sq.Set(i, fmt.Sprintf("v%d", i))
+	}
+	if got := sq.Len(); got != n {
+		t.Fatalf("Len() = %d, want %d", got, n)
+	}
+	for i := 0; i < n; i++ {
+		if v, ok := sq.Get(i); !ok || v != fmt.Sprintf("v%d", i) {
+			t.Fatalf("Get(%d) = %q, %v; want v%d, true", i, v, ok, i)
+		}
+	}
+
+	if !sq.Remove(0) {
+		t.Fatal("Remove(0) = false, want true")
+	}
+	if _, ok := sq.Get(0); ok {
+		t.Fatal("key 0 should be gone after Remove")
+	}
+}
+
+// BenchmarkCacheSetGetParallel measures a single Cache under GOMAXPROCS-way
+// parallel load, where every goroutine contends on the one mutex.
+func BenchmarkCacheSetGetParallel(b *testing.B) {
+	c := NewCache[int, int](1024, NewLRU[int, int]())
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			key := i % 1024
+			c.Set(key, i)
+			c.Get(key)
+			i++
+		}
+	})
+}
+
+// BenchmarkShardedCacheSetGetParallel measures the same workload against a
+// ShardedCache, where goroutines mostly land on different shards and so
+// mostly avoid contending on the same mutex.
+func BenchmarkShardedCacheSetGetParallel(b *testing.B) {
+	sc := NewShardedCache[int, int](16, 64, func() EvictionPolicy[int, int] {
+		return NewLRU[int, int]()
+	})
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			key := i % 1024
+			sc.Set(key, i)
+			sc.Get(key)
+			i++
+		}
+	})
+}
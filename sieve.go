@@ -0,0 +1,71 @@
+package cache
+
+import "github.com/atul-007/cached/internal"
+
+// SIEVE is a scan-resistant eviction policy that approximates LRU's hit
+// ratio without reordering the list on every access. New items enter
+// unvisited at the head (via Cache.Set's PushFront); Access only flips the
+// visited flag. Eviction walks a hand from tail toward head, clearing
+// visited flags as it goes and evicting the first unvisited node it finds.
+// The hand must survive across evictions, so unlike the other policies
+// SIEVE is not stateless and must be constructed with NewSIEVE.
+type SIEVE[K comparable, V any] struct {
+	hand *internal.Entry[K, V] // last position the eviction scan stopped at
+}
+
+func NewSIEVE[K comparable, V any]() *SIEVE[K, V] {
+	return &SIEVE[K, V]{}
+}
+
+func (p *SIEVE[K, V]) Add(evictionList *internal.List[K, V], item *internal.Entry[K, V]) {
+	// New items start unvisited at the head; the hand is left untouched.
+}
+
+func (p *SIEVE[K, V]) Remove(evictionList *internal.List[K, V]) *internal.Entry[K, V] {
+	hand := p.hand
+	if hand == nil {
+		hand = evictionList.Back()
+	}
+
+	for hand != nil {
+		if hand.Visited {
+			hand.Visited = false
+			hand = p.retreat(evictionList, hand)
+			continue
+		}
+
+		// hand now points at the victim; move it to the predecessor so the
+		// next eviction resumes from there once this node is unlinked.
+		p.hand = p.retreat(evictionList, hand)
+		return hand
+	}
+	return nil
+}
+
+func (p *SIEVE[K, V]) Access(evictionList *internal.List[K, V], item *internal.Entry[K, V]) {
+	item.Visited = true
+}
+
+// Evicted drops the hand if it was pointing at item, which Cache just
+// unlinked (a capacity eviction, an overwriting Set, an explicit
+// Cache.Remove, TTL expiry, or Purge). Without this the hand would be left
+// referencing a detached node whose prev/next are nil, and the next
+// Remove call would dereference them. A nil hand simply restarts the scan
+// from the current tail, which is always safe.
+func (p *SIEVE[K, V]) Evicted(evictionList *internal.List[K, V], item *internal.Entry[K, V]) {
+	if p.hand == item {
+		p.hand = nil
+	}
+}
+
+// retreat returns the node before e, wrapping around to the tail. It
+// returns nil if e is the only node left in the list.
+func (p *SIEVE[K, V]) retreat(evictionList *internal.List[K, V], e *internal.Entry[K, V]) *internal.Entry[K, V] {
+	if prev := e.Prev(); prev != nil {
+		return prev
+	}
+	if back := evictionList.Back(); back != e {
+		return back
+	}
+	return nil
+}
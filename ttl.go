@@ -0,0 +1,92 @@
+package cache
+
+import "time"
+
+// EvictReason identifies why OnEvicted was called for a given entry.
+type EvictReason int
+
+const (
+	// EvictReasonCapacity means the entry was evicted to make room for a
+	// new one, as chosen by the Cache's EvictionPolicy.
+	EvictReasonCapacity EvictReason = iota
+	// EvictReasonExpired means the entry's TTL had elapsed, either when
+	// accessed via Get/Peek or swept by the background janitor.
+	EvictReasonExpired
+	// EvictReasonRemoved means the entry was removed explicitly, via
+	// Remove or Purge.
+	EvictReasonRemoved
+)
+
+func (r EvictReason) String() string {
+	switch r {
+	case EvictReasonCapacity:
+		return "capacity"
+	case EvictReasonExpired:
+		return "expired"
+	case EvictReasonRemoved:
+		return "removed"
+	default:
+		return "unknown"
+	}
+}
+
+// Option configures a Cache at construction time. See WithCleanupInterval
+// and WithOnEvicted.
+type Option[K comparable, V any] func(*Cache[K, V])
+
+// WithCleanupInterval starts a background goroutine that sweeps expired
+// entries every d, independently of the size-based eviction policy. Without
+// it, expired entries are only removed lazily, on the next Get/Peek that
+// touches them. Call Cache.Close to stop the goroutine.
+func WithCleanupInterval[K comparable, V any](d time.Duration) Option[K, V] {
+	return func(c *Cache[K, V]) {
+		c.cleanupInterval = d
+	}
+}
+
+// WithOnEvicted registers a callback invoked whenever an entry leaves the
+// cache, whether due to capacity pressure, TTL expiry, or an explicit
+// Remove/Purge.
+func WithOnEvicted[K comparable, V any](fn func(key K, value V, reason EvictReason)) Option[K, V] {
+	return func(c *Cache[K, V]) {
+		c.onEvicted = fn
+	}
+}
+
+// NewCacheWithDefaultTTL is like NewCache but applies ttl to every entry
+// added through Set. SetWithTTL can still override it per call.
+func NewCacheWithDefaultTTL[K comparable, V any](capacity int, policy EvictionPolicy[K, V], ttl time.Duration, opts ...Option[K, V]) *Cache[K, V] {
+	c := newCache(capacity, policy, opts...)
+	c.defaultTTL = ttl
+	return c
+}
+
+// janitor periodically sweeps expired entries until stopCh is closed by
+// Close. stopCh is passed in rather than read from c.stopCh so the read
+// doesn't race with Close's write under c.mu.
+func (c *Cache[K, V]) janitor(interval time.Duration, stopCh chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.deleteExpired()
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+func (c *Cache[K, V]) deleteExpired() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for el := c.evictionList.Front(); el != nil; {
+		next := el.Next()
+		if c.expired(el) {
+			c.removeEntry(el, EvictReasonExpired)
+		}
+		el = next
+	}
+}
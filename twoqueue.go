@@ -0,0 +1,202 @@
+package cache
+
+import (
+	"sync"
+
+	"github.com/atul-007/cached/internal"
+)
+
+// TwoQueue implements the 2Q eviction algorithm (Johnson & Shasha): a small
+// recency-only FIFO (A1in) absorbs one-off scans before they can pollute the
+// main cache, a ghost FIFO of evicted keys (A1out) remembers what was
+// recently pushed out of A1in, and a conventional LRU (Am) holds anything
+// that has proven itself by a second access.
+//
+// Like ARC (see the longer rationale on the ARC type), this needs its own
+// A1in/A1out/Am lists and a ghost key that must stay invisible to Cache's
+// storage map while still steering eviction, which an EvictionPolicy
+// plugged into Cache[K, V] can't express without leaking that complexity
+// into every other policy. So TwoQueue ships as its own cache type with the
+// same Get/Set/Remove/Len/Purge shape instead.
+//
+// Same consequence as ARC: no TTL expiry, no OnEvicted callback, no
+// WithCleanupInterval janitor — those are Cache[K, V] features TwoQueue
+// doesn't inherit by standing apart from it. Use NewShardedTwoQueue to
+// shard a TwoQueue, not NewShardedCache.
+type TwoQueue[K comparable, V any] struct {
+	mu sync.Mutex
+
+	capacity   int
+	recentSize int // target size of in (A1in), ~25% of capacity
+	ghostSize  int // target size of out (A1out), ~50% of capacity
+
+	in, out, main                *internal.List[K, V]
+	inIndex, outIndex, mainIndex map[K]*internal.Entry[K, V]
+}
+
+// NewTwoQueue returns a TwoQueue cache with room for capacity live entries
+// across A1in and Am combined.
+func NewTwoQueue[K comparable, V any](capacity int) *TwoQueue[K, V] {
+	recentSize := capacity / 4
+	if recentSize < 1 {
+		recentSize = 1
+	}
+	ghostSize := capacity / 2
+	if ghostSize < 1 {
+		ghostSize = 1
+	}
+	return &TwoQueue[K, V]{
+		capacity:   capacity,
+		recentSize: recentSize,
+		ghostSize:  ghostSize,
+		in:         internal.NewList[K, V](),
+		out:        internal.NewList[K, V](),
+		main:       internal.NewList[K, V](),
+		inIndex:    make(map[K]*internal.Entry[K, V]),
+		outIndex:   make(map[K]*internal.Entry[K, V]),
+		mainIndex:  make(map[K]*internal.Entry[K, V]),
+	}
+}
+
+// Get returns the cached value for key. A hit in Am moves it to the front;
+// a hit in A1in is left in place, since 2Q only promotes on a second
+// distinct access, not a second read of the same insert.
+func (c *TwoQueue[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.mainIndex[key]; ok {
+		c.main.MoveToFront(el)
+		return el.Value, true
+	}
+	if el, ok := c.inIndex[key]; ok {
+		return el.Value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Set inserts or updates key. A ghost hit in A1out promotes straight to Am
+// (the key has now been seen twice); anything else new goes into A1in.
+func (c *TwoQueue[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.mainIndex[key]; ok {
+		el.Value = value
+		c.main.MoveToFront(el)
+		return
+	}
+	if el, ok := c.inIndex[key]; ok {
+		el.Value = value
+		return
+	}
+	if _, ok := c.outIndex[key]; ok {
+		c.removeGhost(key)
+		c.ensureMainSpace()
+		e := c.main.PushFront(key, value)
+		c.mainIndex[key] = e
+		return
+	}
+
+	c.ensureRecentSpace()
+	e := c.in.PushFront(key, value)
+	c.inIndex[key] = e
+}
+
+// ensureRecentSpace makes room in A1in for one more entry once the cache as
+// a whole is full: it spills the oldest A1in entry into the A1out ghost list
+// when A1in exceeds its target size (or when Am is empty, so A1in holds
+// every live entry), and otherwise evicts straight from Am.
+func (c *TwoQueue[K, V]) ensureRecentSpace() {
+	if c.in.Len()+c.main.Len() < c.capacity {
+		return
+	}
+	if c.in.Len() > c.recentSize || (c.main.Len() == 0 && c.in.Len() > 0) {
+		el := c.in.Back()
+		c.in.Remove(el)
+		delete(c.inIndex, el.Key)
+
+		if c.out.Len() >= c.ghostSize {
+			tail := c.out.Back()
+			c.out.Remove(tail)
+			delete(c.outIndex, tail.Key)
+		}
+		eg := c.out.PushFront(el.Key, el.Value)
+		c.outIndex[el.Key] = eg
+		return
+	}
+	if el := c.main.Back(); el != nil {
+		c.main.Remove(el)
+		delete(c.mainIndex, el.Key)
+	}
+}
+
+// ensureMainSpace evicts Am's least recently used entry if promoting a
+// ghost hit into Am would put the cache over capacity. When Am is empty it
+// spills A1in's oldest entry into A1out instead, via ensureRecentSpace.
+func (c *TwoQueue[K, V]) ensureMainSpace() {
+	if c.in.Len()+c.main.Len() < c.capacity {
+		return
+	}
+	if c.main.Len() == 0 {
+		c.ensureRecentSpace()
+		return
+	}
+	if el := c.main.Back(); el != nil {
+		c.main.Remove(el)
+		delete(c.mainIndex, el.Key)
+	}
+}
+
+func (c *TwoQueue[K, V]) removeGhost(key K) {
+	if el, ok := c.outIndex[key]; ok {
+		c.out.Remove(el)
+		delete(c.outIndex, key)
+	}
+}
+
+// Remove deletes key from whichever list holds it (A1in, Am, or the A1out
+// ghost list) and reports whether anything was removed.
+func (c *TwoQueue[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.inIndex[key]; ok {
+		c.in.Remove(el)
+		delete(c.inIndex, key)
+		return true
+	}
+	if el, ok := c.mainIndex[key]; ok {
+		c.main.Remove(el)
+		delete(c.mainIndex, key)
+		return true
+	}
+	if el, ok := c.outIndex[key]; ok {
+		c.out.Remove(el)
+		delete(c.outIndex, key)
+		return true
+	}
+	return false
+}
+
+// Len returns the number of live entries (A1in + Am); ghost keys don't
+// count.
+func (c *TwoQueue[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.in.Len() + c.main.Len()
+}
+
+// Purge removes every live and ghost entry.
+func (c *TwoQueue[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.in = internal.NewList[K, V]()
+	c.out = internal.NewList[K, V]()
+	c.main = internal.NewList[K, V]()
+	c.inIndex = make(map[K]*internal.Entry[K, V])
+	c.outIndex = make(map[K]*internal.Entry[K, V])
+	c.mainIndex = make(map[K]*internal.Entry[K, V])
+}
@@ -0,0 +1,59 @@
+package cache
+
+import "testing"
+
+// TestTwoQueueGhostHitPromotesToMain checks 2Q's core behavior: a key
+// pushed out of A1in into the A1out ghost list gets promoted straight into
+// Am (the main LRU) the next time it's set, since a ghost hit means it's
+// now been seen twice.
+func TestTwoQueueGhostHitPromotesToMain(t *testing.T) {
+	// capacity 2 -> recentSize = 1, so the third distinct insert spills
+	// the oldest A1in entry (key 1) out into the A1out ghost list.
+	q := NewTwoQueue[int, string](2)
+
+	q.Set(1, "a")
+	q.Set(2, "b")
+	q.Set(3, "c") // spills key 1 out of A1in into A1out
+
+	if _, ok := q.outIndex[1]; !ok {
+		t.Fatal("key 1 should have been spilled into the A1out ghost list")
+	}
+
+	q.Set(1, "a-again") // ghost hit in A1out
+
+	if _, ok := q.mainIndex[1]; !ok {
+		t.Fatal("key 1 should have been promoted into Am on its A1out ghost hit")
+	}
+	if _, ok := q.outIndex[1]; ok {
+		t.Fatal("key 1 should have been removed from A1out once promoted")
+	}
+	if v, ok := q.Get(1); !ok || v != "a-again" {
+		t.Fatalf("Get(1) = %q, %v; want %q, true", v, ok, "a-again")
+	}
+}
+
+// TestTwoQueueRemoveAndLen exercises the bookkeeping methods that parallel
+// Cache's surface.
+func TestTwoQueueRemoveAndLen(t *testing.T) {
+	q := NewTwoQueue[int, string](4)
+	q.Set(1, "a")
+	q.Set(2, "b")
+
+	if got := q.Len(); got != 2 {
+		t.Fatalf("Len() = %d, want 2", got)
+	}
+	if !q.Remove(1) {
+		t.Fatal("Remove(1) = false, want true")
+	}
+	if _, ok := q.Get(1); ok {
+		t.Fatal("key 1 should be gone after Remove")
+	}
+	if got := q.Len(); got != 1 {
+		t.Fatalf("Len() after Remove = %d, want 1", got)
+	}
+
+	q.Purge()
+	if got := q.Len(); got != 0 {
+		t.Fatalf("Len() after Purge = %d, want 0", got)
+	}
+}